@@ -0,0 +1,146 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// celEnv is the single CEL environment every JVMChaos ActionParameterRules
+// expression compiles against: a `params` map holding the merged
+// flags+matchers of the JVMChaos being validated.
+var celEnv = mustNewJVMChaosCELEnv()
+
+func mustNewJVMChaosCELEnv() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("params", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		panic(fmt.Sprintf("failed to build CEL environment for JVMChaos validation: %s", err))
+	}
+	return env
+}
+
+// celProgramCache holds one compiled cel.Program per expression source, so
+// an expression referenced by many JVMChaos objects (or repeatedly by the
+// same one across reconciles) is only ever compiled once.
+var celProgramCache sync.Map // map[string]cel.Program
+
+func compiledCELProgram(expr string) (cel.Program, error) {
+	if cached, ok := celProgramCache.Load(expr); ok {
+		return cached.(cel.Program), nil
+	}
+
+	ast, issues := celEnv.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	prg, err := celEnv.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	celProgramCache.Store(expr, prg)
+	return prg, nil
+}
+
+// init compiles every CELExpressions entry in the built-in JvmSpec eagerly,
+// so a typo in a constraint panics at package initialisation instead of
+// surfacing as a webhook 500 the first time a user hits that action.
+func init() {
+	for target, actions := range JvmSpec {
+		for action, actionPR := range actions {
+			for _, expr := range actionPR.CELExpressions {
+				if _, err := compiledCELProgram(expr); err != nil {
+					panic(fmt.Sprintf("invalid CEL expression %q for %s/%s in JvmSpec: %s", expr, target, action, err))
+				}
+			}
+		}
+	}
+}
+
+// celParams builds the map view CELExpressions evaluate against: Flags and
+// Matcher merged into one namespace, keyed by parameter name and typed per
+// the matching ParameterRules.ParameterType. A parameter the user didn't
+// set is simply omitted rather than mapped to null, so `has(params.x)`
+// reports presence rather than nullness, and a value that fails to parse
+// per its declared type is likewise omitted — validateParameterRules
+// already reports that as a field.Invalid on its own.
+func celParams(actionPR ActionParameterRules, flags, matchers map[string]string) map[string]interface{} {
+	params := make(map[string]interface{})
+	addTyped := func(rules []ParameterRules, values map[string]string) {
+		for _, rule := range rules {
+			value, ok := values[rule.Name]
+			if !ok {
+				continue
+			}
+			switch rule.ParameterType {
+			case IntType:
+				if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+					params[rule.Name] = n
+				}
+			case BoolType:
+				if b, err := strconv.ParseBool(value); err == nil {
+					params[rule.Name] = b
+				}
+			default:
+				params[rule.Name] = value
+			}
+		}
+	}
+	addTyped(actionPR.Flags, flags)
+	addTyped(actionPR.Matcher, matchers)
+	return params
+}
+
+// validateCELExpressions runs actionPR.CELExpressions against the merged
+// flags+matchers of in.Spec. It is called after validateParameterRules has
+// already checked per-field presence and type, so expressions can assume
+// any parameter they reference either satisfies its declared type or is
+// absent.
+func (in *JVMChaos) validateCELExpressions(actionPR ActionParameterRules, parent *field.Path, target *field.Path, action *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(actionPR.CELExpressions) == 0 {
+		return allErrs
+	}
+
+	params := celParams(actionPR, in.Spec.Flags, in.Spec.Matchers)
+	for _, expr := range actionPR.CELExpressions {
+		prg, err := compiledCELProgram(expr)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(parent, fmt.Errorf("invalid CEL expression %q: %w", expr, err)))
+			continue
+		}
+
+		out, _, err := prg.Eval(map[string]interface{}{"params": params})
+		if err != nil {
+			errorMsg := fmt.Sprintf("with %s: %s, %s: %s, failed to evaluate constraint %q: %s",
+				target, in.Spec.Target, action, in.Spec.Action, expr, err)
+			allErrs = append(allErrs, field.Invalid(parent, expr, errorMsg))
+			continue
+		}
+
+		if pass, ok := out.Value().(bool); !ok || !pass {
+			errorMsg := fmt.Sprintf("with %s: %s, %s: %s, constraint failed: %s",
+				target, in.Spec.Target, action, in.Spec.Action, expr)
+			allErrs = append(allErrs, field.Invalid(parent, expr, errorMsg))
+		}
+	}
+	return allErrs
+}
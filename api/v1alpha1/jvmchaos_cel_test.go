@@ -0,0 +1,193 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestCelParams(t *testing.T) {
+	rules := ActionParameterRules{
+		Flags: []ParameterRules{
+			{Name: "time", ParameterType: IntType},
+		},
+		Matcher: []ParameterRules{
+			{Name: "effect-count", ParameterType: IntType},
+			{Name: "wild-mode", ParameterType: BoolType},
+			{Name: "method"},
+		},
+	}
+
+	params := celParams(rules,
+		map[string]string{"time": "not-a-number"},
+		map[string]string{"wild-mode": "true", "method": "GET"},
+	)
+
+	if _, ok := params["time"]; ok {
+		t.Errorf("expected an unparseable int value to be omitted, got %v", params["time"])
+	}
+	if _, ok := params["effect-count"]; ok {
+		t.Errorf("expected a parameter the user never set to be omitted, got %v", params["effect-count"])
+	}
+	if v, ok := params["wild-mode"].(bool); !ok || !v {
+		t.Errorf("expected wild-mode to be parsed as the bool true, got %#v", params["wild-mode"])
+	}
+	if v, ok := params["method"].(string); !ok || v != "GET" {
+		t.Errorf("expected method to be passed through as the string GET, got %#v", params["method"])
+	}
+}
+
+func TestValidateCELExpressions(t *testing.T) {
+	targetField := field.NewPath("spec").Child("target")
+	actionField := field.NewPath("spec").Child("action")
+	flagsField := field.NewPath("spec").Child("flags")
+
+	// celParams only maps parameters that have a rule, so every case is run
+	// with the same typed Flags/Matcher rules.
+	flagRules := []ParameterRules{
+		{Name: "time", ParameterType: IntType},
+		{Name: "block", ParameterType: IntType},
+		{Name: "wild-mode", ParameterType: BoolType},
+		{Name: "script-content"},
+		{Name: "script-type"},
+	}
+	matcherRules := []ParameterRules{
+		{Name: "effect-count", ParameterType: IntType},
+		{Name: "effect-percent", ParameterType: IntType},
+	}
+
+	cases := []struct {
+		name     string
+		actionPR ActionParameterRules
+		flags    map[string]string
+		matchers map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "mutual exclusion: passes with only one of effect-count/effect-percent set",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				},
+			},
+			matchers: map[string]string{"effect-count": "1"},
+		},
+		{
+			name: "mutual exclusion: fails with both effect-count and effect-percent set",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				},
+			},
+			matchers: map[string]string{"effect-count": "1", "effect-percent": "50"},
+			wantErr:  true,
+		},
+		{
+			name: "range check: passes within bounds",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+				},
+			},
+			flags: map[string]string{"time": "1000"},
+		},
+		{
+			name: "range check: fails below the lower bound",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+				},
+			},
+			flags:   map[string]string{"time": "-5"},
+			wantErr: true,
+		},
+		{
+			name: "range check: fails above the upper bound",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+				},
+			},
+			flags:   map[string]string{"time": "3600001"},
+			wantErr: true,
+		},
+		{
+			name: "required-together: passes when script-content is absent",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!has(params["script-content"]) || has(params["script-type"])`,
+				},
+			},
+		},
+		{
+			name: "required-together: passes when script-content and script-type are both set",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!has(params["script-content"]) || has(params["script-type"])`,
+				},
+			},
+			flags: map[string]string{"script-content": "print(1)", "script-type": "javascript"},
+		},
+		{
+			name: "required-together: fails when script-content is set without script-type",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!has(params["script-content"]) || has(params["script-type"])`,
+				},
+			},
+			flags:   map[string]string{"script-content": "print(1)"},
+			wantErr: true,
+		},
+		{
+			name: "wild-mode/block: passes when wild-mode is true and block is unset",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!(has(params["wild-mode"]) && params["wild-mode"] && has(params.block) && params.block != 0)`,
+				},
+			},
+			flags: map[string]string{"wild-mode": "true"},
+		},
+		{
+			name: "wild-mode/block: fails when wild-mode is true and block is nonzero",
+			actionPR: ActionParameterRules{
+				CELExpressions: []string{
+					`!(has(params["wild-mode"]) && params["wild-mode"] && has(params.block) && params.block != 0)`,
+				},
+			},
+			flags:   map[string]string{"wild-mode": "true", "block": "10"},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			in := &JVMChaos{}
+			in.Spec.Target = SERVLET
+			in.Spec.Action = JVMDelayAction
+			in.Spec.Flags = c.flags
+			in.Spec.Matchers = c.matchers
+
+			actionPR := c.actionPR
+			actionPR.Flags = flagRules
+			actionPR.Matcher = matcherRules
+
+			errs := in.validateCELExpressions(actionPR, flagsField, targetField, actionField)
+			if gotErr := len(errs) > 0; gotErr != c.wantErr {
+				t.Errorf("validateCELExpressions() errors = %v, wantErr %v", errs, c.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+var jvmSpecProviderLog = logf.Log.WithName("jvmchaos-spec-provider")
+
+// Default location of the ConfigMap a ConfigMapJVMSpecProvider watches.
+const (
+	JVMSpecConfigMapNamespace = "chaos-mesh"
+	JVMSpecConfigMapName      = "jvm-spec"
+
+	jvmSpecConfigMapDataKey = "spec.yaml"
+)
+
+// JVMSpecProvider resolves the ActionParameterRules that govern a JVMChaos
+// target/action pair. validateJvmChaos calls through a JVMSpecProvider
+// rather than the JvmSpec map directly, so new targets, actions and
+// parameter rules can be registered for validation at runtime without
+// recompiling the controller. Providers never write into JvmSpec, so code
+// that reads JvmSpec directly does not see rules registered this way.
+//
+// +kubebuilder:object:generate=false
+type JVMSpecProvider interface {
+	// Actions returns the action rules registered for target, and whether
+	// the target is known at all.
+	Actions(target JVMChaosTarget) (map[JVMChaosAction]ActionParameterRules, bool)
+	// Targets lists every target currently registered.
+	Targets() []JVMChaosTarget
+}
+
+var (
+	jvmSpecProviderMu sync.RWMutex
+	jvmSpecProvider   JVMSpecProvider = NewStaticJVMSpecProvider(JvmSpec)
+)
+
+// CurrentJVMSpecProvider returns the provider consulted by validateJvmChaos.
+func CurrentJVMSpecProvider() JVMSpecProvider {
+	jvmSpecProviderMu.RLock()
+	defer jvmSpecProviderMu.RUnlock()
+	return jvmSpecProvider
+}
+
+// SetJVMSpecProvider replaces the provider consulted by validateJvmChaos.
+// The manager calls this once at startup to switch from the built-in,
+// in-code rules to a ConfigMap-backed registry.
+func SetJVMSpecProvider(p JVMSpecProvider) {
+	jvmSpecProviderMu.Lock()
+	defer jvmSpecProviderMu.Unlock()
+	jvmSpecProvider = p
+}
+
+// staticJVMSpecProvider serves a fixed, in-code spec. It is the default
+// provider, and the base a ConfigMapJVMSpecProvider merges onto.
+type staticJVMSpecProvider struct {
+	spec map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules
+}
+
+// NewStaticJVMSpecProvider wraps a fixed spec map as a JVMSpecProvider.
+func NewStaticJVMSpecProvider(spec map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules) JVMSpecProvider {
+	return &staticJVMSpecProvider{spec: spec}
+}
+
+func (p *staticJVMSpecProvider) Actions(target JVMChaosTarget) (map[JVMChaosAction]ActionParameterRules, bool) {
+	actions, ok := p.spec[target]
+	return actions, ok
+}
+
+func (p *staticJVMSpecProvider) Targets() []JVMChaosTarget {
+	targets := make([]JVMChaosTarget, 0, len(p.spec))
+	for t := range p.spec {
+		targets = append(targets, t)
+	}
+	return targets
+}
+
+// configMapJVMSpec mirrors the `spec.yaml` key of the jvm-spec ConfigMap: a
+// flat list of target/action rule sets, so the YAML an operator hand-writes
+// reads naturally instead of as a Go map keyed by arbitrary strings.
+//
+// Merge semantics: an entry is matched to the fallback provider by
+// (target, action). If the fallback already has rules for that pair, the
+// ConfigMap entry replaces them in full — Flags and Matcher are not
+// field-merged, so it's always unambiguous which rule set applies to a
+// given parameter name. A target or action absent from the ConfigMap is
+// left untouched. This lets operators register a brand-new target (e.g.
+// Kafka) alongside the unmodified built-ins, or override a single action's
+// rules, without restating the whole spec.
+type configMapJVMSpec struct {
+	Targets []configMapJVMSpecTarget `json:"targets"`
+}
+
+type configMapJVMSpecTarget struct {
+	Target  JVMChaosTarget           `json:"target"`
+	Actions []configMapJVMSpecAction `json:"actions"`
+}
+
+type configMapJVMSpecAction struct {
+	Action               JVMChaosAction `json:"action"`
+	ActionParameterRules `json:",inline"`
+}
+
+// jvmSpecConfigMapSchema is the JSON schema the `spec.yaml` key of the
+// jvm-spec ConfigMap must validate against.
+const jvmSpecConfigMapSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "chaos-mesh JVMChaos spec registry",
+  "type": "object",
+  "required": ["targets"],
+  "properties": {
+    "targets": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["target", "actions"],
+        "properties": {
+          "target": {"type": "string"},
+          "actions": {
+            "type": "array",
+            "items": {
+              "type": "object",
+              "required": ["action"],
+              "properties": {
+                "action": {"type": "string"},
+                "Flags": {"type": "array", "items": {"$ref": "#/definitions/parameterRule"}},
+                "Matcher": {"type": "array", "items": {"$ref": "#/definitions/parameterRule"}},
+                "CELExpressions": {"type": "array", "items": {"type": "string"}}
+              }
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "parameterRule": {
+      "type": "object",
+      "required": ["Name"],
+      "properties": {
+        "Name": {"type": "string"},
+        "ParameterType": {"type": "string", "enum": ["int", "bool", "string"]},
+        "Required": {"type": "boolean"},
+        "Min": {"type": "integer"},
+        "Max": {"type": "integer"},
+        "Enum": {"type": "array", "items": {"type": "string"}},
+        "Pattern": {"type": "string"}
+      }
+    }
+  }
+}`
+
+// ConfigMapJVMSpecProvider watches a namespaced ConfigMap and merges its
+// contents onto a fallback provider (normally the built-in
+// staticJVMSpecProvider), so operators can register new JVM chaos
+// targets/actions/parameter rules at runtime without a controller rebuild
+// or webhook cert reissue.
+type ConfigMapJVMSpecProvider struct {
+	client   client.Client
+	name     types.NamespacedName
+	fallback JVMSpecProvider
+
+	mu     sync.RWMutex
+	merged map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules
+}
+
+// NewConfigMapJVMSpecProvider builds a provider that merges name's data onto
+// fallback. Until Reload has been called at least once (normally from the
+// controller-runtime watch handler registered for name), Actions/Targets
+// behave exactly like fallback.
+func NewConfigMapJVMSpecProvider(c client.Client, name types.NamespacedName, fallback JVMSpecProvider) *ConfigMapJVMSpecProvider {
+	return &ConfigMapJVMSpecProvider{
+		client:   c,
+		name:     name,
+		fallback: fallback,
+	}
+}
+
+func (p *ConfigMapJVMSpecProvider) Actions(target JVMChaosTarget) (map[JVMChaosAction]ActionParameterRules, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if actions, ok := p.merged[target]; ok {
+		return actions, true
+	}
+	return p.fallback.Actions(target)
+}
+
+func (p *ConfigMapJVMSpecProvider) Targets() []JVMChaosTarget {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	seen := make(map[JVMChaosTarget]struct{})
+	targets := make([]JVMChaosTarget, 0, len(p.merged))
+	for _, t := range p.fallback.Targets() {
+		seen[t] = struct{}{}
+		targets = append(targets, t)
+	}
+	for t := range p.merged {
+		if _, ok := seen[t]; !ok {
+			seen[t] = struct{}{}
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// Reload re-reads the watched ConfigMap and recomputes the merged view. It
+// is safe to call concurrently with Actions/Targets, and is meant to be
+// invoked by the controller-runtime watch handler on every add/update event
+// for the ConfigMap, so the next validation of a JVMChaos uses the new
+// rules without a pod restart.
+func (p *ConfigMapJVMSpecProvider) Reload(ctx context.Context) error {
+	cm := &corev1.ConfigMap{}
+	if err := p.client.Get(ctx, p.name, cm); err != nil {
+		return fmt.Errorf("failed to read jvm-spec ConfigMap %s: %w", p.name, err)
+	}
+
+	raw, ok := cm.Data[jvmSpecConfigMapDataKey]
+	if !ok {
+		return fmt.Errorf("jvm-spec ConfigMap %s has no %q key", p.name, jvmSpecConfigMapDataKey)
+	}
+
+	var doc configMapJVMSpec
+	if err := yaml.Unmarshal([]byte(raw), &doc); err != nil {
+		return fmt.Errorf("failed to parse jvm-spec ConfigMap %s: %w", p.name, err)
+	}
+
+	merged := make(map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules, len(doc.Targets))
+	for _, t := range doc.Targets {
+		actions := make(map[JVMChaosAction]ActionParameterRules)
+		if fallbackActions, ok := p.fallback.Actions(t.Target); ok {
+			for a, rules := range fallbackActions {
+				actions[a] = rules
+			}
+		}
+		for _, a := range t.Actions {
+			actions[a.Action] = a.ActionParameterRules
+		}
+		merged[t.Target] = actions
+	}
+
+	p.mu.Lock()
+	p.merged = merged
+	p.mu.Unlock()
+
+	jvmSpecProviderLog.Info("reloaded jvm-spec ConfigMap", "name", p.name, "targets", len(merged))
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// JVMSpecConfigMapReconciler keeps the process-wide JVMSpecProvider (see
+// SetJVMSpecProvider) in sync with the jvm-spec ConfigMap it watches. Once
+// registered via SetupJVMSpecProviderWithManager, editing the ConfigMap
+// makes the next validation of a JVMChaos use the new rules without a
+// controller restart.
+type JVMSpecConfigMapReconciler struct {
+	client.Client
+	Log  logr.Logger
+	Name types.NamespacedName
+
+	provider *ConfigMapJVMSpecProvider
+}
+
+// SetupJVMSpecProviderWithManager builds a ConfigMapJVMSpecProvider over
+// name, installs it as the process-wide JVMSpecProvider immediately (until
+// the reconciler's first Reload it serves the built-in rules unchanged),
+// and registers the reconciler that keeps it up to date. Call this
+// once from manager setup, alongside JVMChaos's webhook registration.
+func SetupJVMSpecProviderWithManager(mgr ctrl.Manager, name types.NamespacedName) error {
+	provider := NewConfigMapJVMSpecProvider(mgr.GetClient(), name, NewStaticJVMSpecProvider(JvmSpec))
+	SetJVMSpecProvider(provider)
+
+	r := &JVMSpecConfigMapReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("JVMSpecConfigMap"),
+		Name:     name,
+		provider: provider,
+	}
+	return r.SetupWithManager(mgr)
+}
+
+// SetupWithManager registers r to reconcile only the ConfigMap named by
+// r.Name; every other ConfigMap in the cluster is filtered out before it
+// ever reaches Reconcile.
+func (r *JVMSpecConfigMapReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.ConfigMap{}).
+		WithEventFilter(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetNamespace() == r.Name.Namespace && obj.GetName() == r.Name.Name
+		})).
+		Complete(r)
+}
+
+// Reconcile re-reads the jvm-spec ConfigMap and recomputes the merged view
+// served by the process-wide JVMSpecProvider. A deleted ConfigMap is not an
+// error: the provider simply keeps serving whatever it last merged (or the
+// built-in rules, if it never saw one).
+func (r *JVMSpecConfigMapReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if err := r.provider.Reload(ctx); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Log.Info("jvm-spec ConfigMap not found, keeping previously loaded rules", "name", req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "failed to reload jvm-spec ConfigMap", "name", req.NamespacedName)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
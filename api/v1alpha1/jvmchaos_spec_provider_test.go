@@ -0,0 +1,129 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestConfigMapJVMSpecProviderReload flips a target's rules by Reload-ing
+// twice with different ConfigMap contents, and asserts Actions/Targets
+// change in between -- the hot-reload-without-restart behavior the
+// ConfigMap-backed provider exists for.
+func TestConfigMapJVMSpecProviderReload(t *testing.T) {
+	ctx := context.Background()
+	name := types.NamespacedName{Namespace: JVMSpecConfigMapNamespace, Name: JVMSpecConfigMapName}
+
+	fallback := NewStaticJVMSpecProvider(map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
+		SERVLET: JvmSpec[SERVLET],
+	})
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name},
+		Data: map[string]string{
+			jvmSpecConfigMapDataKey: `
+targets:
+- target: Kafka
+  actions:
+  - action: delay
+    Matcher:
+    - Name: topic
+      Required: true
+`,
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithObjects(cm).Build()
+	provider := NewConfigMapJVMSpecProvider(fakeClient, name, fallback)
+
+	if _, ok := provider.Actions("Kafka"); ok {
+		t.Fatalf("expected Kafka to be unknown before the first Reload")
+	}
+	if _, ok := provider.Actions(SERVLET); !ok {
+		t.Fatalf("expected SERVLET to be served by the fallback before the first Reload")
+	}
+
+	if err := provider.Reload(ctx); err != nil {
+		t.Fatalf("first Reload: %v", err)
+	}
+
+	kafkaActions, ok := provider.Actions("Kafka")
+	if !ok {
+		t.Fatalf("expected Kafka to be registered after the first Reload")
+	}
+	if _, ok := kafkaActions["delay"]; !ok {
+		t.Fatalf("expected Kafka's delay action to be registered after the first Reload")
+	}
+	if _, ok := provider.Actions(SERVLET); !ok {
+		t.Fatalf("expected SERVLET to still be served by the fallback after the first Reload")
+	}
+	targetsAfterFirst := provider.Targets()
+
+	cm.Data[jvmSpecConfigMapDataKey] = `
+targets:
+- target: MongoDB
+  actions:
+  - action: delay
+    Matcher:
+    - Name: database
+      Required: true
+`
+	if err := fakeClient.Update(ctx, cm); err != nil {
+		t.Fatalf("failed to update the jvm-spec ConfigMap: %v", err)
+	}
+
+	if err := provider.Reload(ctx); err != nil {
+		t.Fatalf("second Reload: %v", err)
+	}
+
+	if _, ok := provider.Actions("Kafka"); ok {
+		t.Fatalf("expected Kafka to no longer be registered after the second Reload")
+	}
+	mongoActions, ok := provider.Actions("MongoDB")
+	if !ok {
+		t.Fatalf("expected MongoDB to be registered after the second Reload")
+	}
+	if _, ok := mongoActions["delay"]; !ok {
+		t.Fatalf("expected MongoDB's delay action to be registered after the second Reload")
+	}
+	if _, ok := provider.Actions(SERVLET); !ok {
+		t.Fatalf("expected SERVLET to still be served by the fallback after the second Reload")
+	}
+
+	if sameTargetSet(targetsAfterFirst, provider.Targets()) {
+		t.Fatalf("expected Targets() to change between reloads, got the same set %v", provider.Targets())
+	}
+}
+
+func sameTargetSet(a, b []JVMChaosTarget) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[JVMChaosTarget]struct{}, len(a))
+	for _, t := range a {
+		seen[t] = struct{}{}
+	}
+	for _, t := range b {
+		if _, ok := seen[t]; !ok {
+			return false
+		}
+	}
+	return true
+}
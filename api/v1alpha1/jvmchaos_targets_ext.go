@@ -0,0 +1,318 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// KAFKA, MONGODB, GRPC, ELASTICSEARCH and HBASE extend the JVMChaosTarget
+// enum declared alongside SERVLET, PSQL, MYSQL et al. in jvmchaos_types.go
+// with targets chaosblade-exec-jvm has since picked up.
+//
+// Scope of this commit: webhook-side validation only. The JvmSpec entries
+// below make validateJvmChaos (via JVMSpecProvider) accept these targets
+// and enforce their matchers/flags, and jvmchaos_targets_ext_test.go
+// covers that acceptance/rejection behavior directly against
+// validateJvmChaos. A JVMChaos with one of these targets cannot yet be
+// applied to a real cluster and has no chaosdaemon support to execute it:
+//
+//   - the CRD's `target` enum (config/crd/bases, the kubebuilder markers
+//     on JVMChaosSpec.Target) does not list them, so the API server's own
+//     OpenAPI validation rejects the object before this webhook ever runs;
+//   - the chaosdaemon JVM injection RPC and chaosblade-exec-jvm resource
+//     model have no Kafka/MongoDB/gRPC/ElasticSearch/HBase support to
+//     dispatch to.
+//
+// Completing the CRD enum, chaosdaemon RPC, and a real end-to-end
+// (apply-to-cluster) test is tracked as follow-up work; neither lives in
+// this package.
+const (
+	KAFKA         JVMChaosTarget = "Kafka"
+	MONGODB       JVMChaosTarget = "MongoDB"
+	GRPC          JVMChaosTarget = "gRPC"
+	ELASTICSEARCH JVMChaosTarget = "ElasticSearch"
+	HBASE         JVMChaosTarget = "HBase"
+)
+
+func init() {
+	JvmSpec[KAFKA] = map[JVMChaosAction]ActionParameterRules{
+		JVMDelayAction: {
+			Flags: []ParameterRules{
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "topic", Required: true},
+				{Name: "partition", ParameterType: IntType},
+				{Name: "clientId"},
+				{Name: "consumerGroup"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
+		},
+		JVMExceptionAction: {
+			Flags: []ParameterRules{
+				{Name: "exception", Required: true},
+				{Name: "exception-message"},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "topic", Required: true},
+				{Name: "partition", ParameterType: IntType},
+				{Name: "clientId"},
+				{Name: "consumerGroup"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
+		},
+		JVMReturnAction: {
+			Flags: []ParameterRules{
+				{Name: "value", Required: true},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "topic", Required: true},
+				{Name: "partition", ParameterType: IntType},
+				{Name: "clientId"},
+				{Name: "consumerGroup"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
+		},
+	}
+
+	JvmSpec[MONGODB] = map[JVMChaosAction]ActionParameterRules{
+		JVMDelayAction: {
+			Flags: []ParameterRules{
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "database", Required: true},
+				{Name: "collection"},
+				{Name: "operation"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
+		},
+		JVMExceptionAction: {
+			Flags: []ParameterRules{
+				{Name: "exception", Required: true},
+				{Name: "exception-message"},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "database", Required: true},
+				{Name: "collection"},
+				{Name: "operation"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
+		},
+		JVMReturnAction: {
+			Flags: []ParameterRules{
+				{Name: "value", Required: true},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "database", Required: true},
+				{Name: "collection"},
+				{Name: "operation"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
+		},
+	}
+
+	JvmSpec[GRPC] = map[JVMChaosAction]ActionParameterRules{
+		JVMDelayAction: {
+			Flags: []ParameterRules{
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "service", Required: true},
+				{Name: "method"},
+				{Name: "client", ParameterType: BoolType},
+				{Name: "server", ParameterType: BoolType},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+				`!(has(params.client) && params.client && has(params.server) && params.server)`,
+			},
+		},
+		JVMExceptionAction: {
+			Flags: []ParameterRules{
+				{Name: "exception", Required: true},
+				{Name: "exception-message"},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "service", Required: true},
+				{Name: "method"},
+				{Name: "client", ParameterType: BoolType},
+				{Name: "server", ParameterType: BoolType},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!(has(params.client) && params.client && has(params.server) && params.server)`,
+			},
+		},
+		JVMReturnAction: {
+			Flags: []ParameterRules{
+				{Name: "value", Required: true},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "service", Required: true},
+				{Name: "method"},
+				{Name: "client", ParameterType: BoolType},
+				{Name: "server", ParameterType: BoolType},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!(has(params.client) && params.client && has(params.server) && params.server)`,
+			},
+		},
+	}
+
+	JvmSpec[ELASTICSEARCH] = map[JVMChaosAction]ActionParameterRules{
+		JVMDelayAction: {
+			Flags: []ParameterRules{
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "index", Required: true},
+				{Name: "operation"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
+		},
+		JVMExceptionAction: {
+			Flags: []ParameterRules{
+				{Name: "exception", Required: true},
+				{Name: "exception-message"},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "index", Required: true},
+				{Name: "operation"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
+		},
+		JVMReturnAction: {
+			Flags: []ParameterRules{
+				{Name: "value", Required: true},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "index", Required: true},
+				{Name: "operation"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
+		},
+	}
+
+	JvmSpec[HBASE] = map[JVMChaosAction]ActionParameterRules{
+		JVMDelayAction: {
+			Flags: []ParameterRules{
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "table", Required: true},
+				{Name: "family"},
+				{Name: "qualifier"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
+		},
+		JVMExceptionAction: {
+			Flags: []ParameterRules{
+				{Name: "exception", Required: true},
+				{Name: "exception-message"},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "table", Required: true},
+				{Name: "family"},
+				{Name: "qualifier"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
+		},
+		JVMReturnAction: {
+			Flags: []ParameterRules{
+				{Name: "value", Required: true},
+			},
+			Matcher: []ParameterRules{
+				{Name: "effect-count", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+				{Name: "table", Required: true},
+				{Name: "family"},
+				{Name: "qualifier"},
+			},
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
+		},
+	}
+
+	for _, actions := range []map[JVMChaosAction]ActionParameterRules{
+		JvmSpec[KAFKA], JvmSpec[MONGODB], JvmSpec[GRPC], JvmSpec[ELASTICSEARCH], JvmSpec[HBASE],
+	} {
+		for _, actionPR := range actions {
+			for _, expr := range actionPR.CELExpressions {
+				if _, err := compiledCELProgram(expr); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
+}
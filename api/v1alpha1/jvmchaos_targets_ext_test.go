@@ -0,0 +1,54 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// TestValidateJvmChaosKafkaTarget is a webhook-only unit test: it calls
+// validateJvmChaos directly, as jvmchaos_cel_test.go and
+// jvmchaos_webhook_test.go call the validation helpers directly, rather
+// than applying a JVMChaos to a real
+// (or fake) API server. It does not exercise the CRD's `target` enum or
+// the chaosdaemon JVM injection RPC -- see the scope note on KAFKA et al.
+// in jvmchaos_targets_ext.go for why those are out of scope here.
+func TestValidateJvmChaosKafkaTarget(t *testing.T) {
+	newSpec := func(matchers map[string]string) *JVMChaos {
+		in := &JVMChaos{}
+		in.Spec.Target = KAFKA
+		in.Spec.Action = JVMDelayAction
+		in.Spec.Flags = map[string]string{"time": "100"}
+		in.Spec.Matchers = matchers
+		return in
+	}
+
+	t.Run("accepts a spec with the required topic matcher", func(t *testing.T) {
+		in := newSpec(map[string]string{"topic": "orders"})
+
+		if errs := in.validateJvmChaos(field.NewPath("spec")); len(errs) != 0 {
+			t.Errorf("expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("rejects a spec missing the required topic matcher", func(t *testing.T) {
+		in := newSpec(nil)
+
+		if errs := in.validateJvmChaos(field.NewPath("spec")); len(errs) == 0 {
+			t.Errorf("expected an error for the missing required topic matcher")
+		}
+	})
+}
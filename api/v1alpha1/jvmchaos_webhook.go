@@ -15,6 +15,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -92,7 +93,7 @@ func (in *JVMChaos) validateJvmChaos(spec *field.Path) field.ErrorList {
 	actionField := spec.Child("action")
 	flagsField := spec.Child("flags")
 	matcherField := spec.Child("matcher")
-	if actions, ok := JvmSpec[in.Spec.Target]; ok {
+	if actions, ok := CurrentJVMSpecProvider().Actions(in.Spec.Target); ok {
 
 		if actionPR, actionOK := actions[in.Spec.Action]; actionOK {
 			if actionPR.Flags != nil {
@@ -103,6 +104,8 @@ func (in *JVMChaos) validateJvmChaos(spec *field.Path) field.ErrorList {
 				allErrs = append(allErrs, in.validateParameterRules(in.Spec.Matchers, actionPR.Matcher, matcherField, targetField, actionField)...)
 			}
 
+			allErrs = append(allErrs, in.validateCELExpressions(actionPR, spec, targetField, actionField)...)
+
 		} else {
 			supportActions := make([]JVMChaosAction, 0)
 			for k := range actions {
@@ -129,6 +132,19 @@ func toString(actions []JVMChaosAction) []string {
 	return ret
 }
 
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func stringInSlice(value string, enum []string) bool {
+	for _, e := range enum {
+		if value == e {
+			return true
+		}
+	}
+	return false
+}
+
 func (in *JVMChaos) validateParameterRules(parameters map[string]string, rules []ParameterRules, parent *field.Path, target *field.Path, action *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for _, rule := range rules {
@@ -152,10 +168,19 @@ func (in *JVMChaos) validateParameterRules(parameters map[string]string, rules [
 		}
 
 		if exist && rule.ParameterType == IntType {
-			_, err := strconv.Atoi(value)
+			n, err := strconv.ParseInt(value, 10, 64)
 			if err != nil {
 				errorMsg := fmt.Sprintf("%s:%s cannot parse as Int", innerField, value)
 				allErrs = append(allErrs, field.Invalid(innerField, value, errorMsg))
+			} else {
+				if rule.Min != nil && n < *rule.Min {
+					errorMsg := fmt.Sprintf("%s:%d must be >= %d", innerField, n, *rule.Min)
+					allErrs = append(allErrs, field.Invalid(innerField, value, errorMsg))
+				}
+				if rule.Max != nil && n > *rule.Max {
+					errorMsg := fmt.Sprintf("%s:%d must be <= %d", innerField, n, *rule.Max)
+					allErrs = append(allErrs, field.Invalid(innerField, value, errorMsg))
+				}
 			}
 		}
 
@@ -166,6 +191,23 @@ func (in *JVMChaos) validateParameterRules(parameters map[string]string, rules [
 				allErrs = append(allErrs, field.Invalid(innerField, value, errorMsg))
 			}
 		}
+
+		if exist && len(rule.Enum) > 0 {
+			if !stringInSlice(value, rule.Enum) {
+				allErrs = append(allErrs, field.NotSupported(innerField, value, rule.Enum))
+			}
+		}
+
+		if exist && rule.Pattern != "" {
+			matched, err := regexp.MatchString("^(?:"+rule.Pattern+")$", value)
+			if err != nil {
+				errorMsg := fmt.Sprintf("%s: invalid pattern %q: %s", innerField, rule.Pattern, err)
+				allErrs = append(allErrs, field.InternalError(innerField, fmt.Errorf(errorMsg)))
+			} else if !matched {
+				errorMsg := fmt.Sprintf("%s:%s does not match pattern %q", innerField, value, rule.Pattern)
+				allErrs = append(allErrs, field.Invalid(innerField, value, errorMsg))
+			}
+		}
 	}
 	return allErrs
 }
@@ -174,20 +216,30 @@ func (in *JVMChaos) validateParameterRules(parameters map[string]string, rules [
 
 // JvmSpec from chaosblade-jvm-spec.yaml
 // chaosblade-jvm-spec.yaml file generated by https://github.com/chaosblade-io/chaosblade-exec-jvm/blob/master/chaosblade-exec-service/src/main/java/com/alibaba/chaosblade/exec/service/build/SpecMain.java
+//
+// This is the built-in data served by the default JVMSpecProvider
+// (CurrentJVMSpecProvider). Operators who need to register a target or
+// action without recompiling the controller should add a jvm-spec
+// ConfigMap instead of editing this map; see ConfigMapJVMSpecProvider.
 var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 	SERVLET: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "method"},
 				{Name: "querystring"},
 				{Name: "requestpath"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -196,28 +248,37 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "method"},
 				{Name: "querystring"},
 				{Name: "requestpath"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 	},
 	PSQL: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "sqltype"},
 				{Name: "database"},
 				{Name: "port", ParameterType: IntType},
 				{Name: "host"},
 				{Name: "table"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -226,30 +287,39 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "sqltype"},
 				{Name: "database"},
 				{Name: "port", ParameterType: IntType},
 				{Name: "host"},
 				{Name: "table"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 	},
 	MYSQL: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "sqltype"},
 				{Name: "database"},
 				{Name: "port", ParameterType: IntType},
 				{Name: "host"},
 				{Name: "table"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -258,27 +328,36 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "sqltype"},
 				{Name: "database"},
 				{Name: "port", ParameterType: IntType},
 				{Name: "host"},
 				{Name: "table"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 	},
 	JEDIS: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "cmd"},
 				{Name: "key"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -287,26 +366,36 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "cmd"},
 				{Name: "key"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 	},
 	HTTP: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "httpclient4", ParameterType: BoolType},
 				{Name: "rest", ParameterType: BoolType},
 				{Name: "httpclient3", ParameterType: BoolType},
 				{Name: "uri", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+				`(has(params.httpclient3) && params.httpclient3 ? 1 : 0) + (has(params.httpclient4) && params.httpclient4 ? 1 : 0) + (has(params.rest) && params.rest ? 1 : 0) <= 1`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -315,27 +404,37 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "httpclient4", ParameterType: BoolType},
 				{Name: "rest", ParameterType: BoolType},
 				{Name: "httpclient3", ParameterType: BoolType},
 				{Name: "uri", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`(has(params.httpclient3) && params.httpclient3 ? 1 : 0) + (has(params.httpclient4) && params.httpclient4 ? 1 : 0) + (has(params.rest) && params.rest ? 1 : 0) <= 1`,
+			},
 		},
 	},
 	ROCKETMQ: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "producerGroup"},
 				{Name: "topic"},
 				{Name: "consumerGroup"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -344,27 +443,36 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "producerGroup"},
 				{Name: "topic"},
 				{Name: "consumerGroup"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 	},
 	TARS: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "servant", ParameterType: BoolType},
 				{Name: "functionname"},
 				{Name: "client", ParameterType: BoolType},
 				{Name: "servantname", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -373,23 +481,27 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "servant", ParameterType: BoolType},
 				{Name: "functionname"},
 				{Name: "client", ParameterType: BoolType},
 				{Name: "servantname", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 	},
 	DUBBO: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "appname"},
 				{Name: "provider", ParameterType: BoolType},
 				{Name: "service"},
@@ -397,6 +509,12 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 				{Name: "consumer", ParameterType: BoolType},
 				{Name: "group"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+				`!(has(params.provider) && params.provider && has(params.consumer) && params.consumer)`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -405,7 +523,7 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "appname"},
 				{Name: "provider", ParameterType: BoolType},
 				{Name: "service"},
@@ -413,28 +531,42 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 				{Name: "consumer", ParameterType: BoolType},
 				{Name: "group"},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!(has(params.provider) && params.provider && has(params.consumer) && params.consumer)`,
+			},
 		},
 		JVMThreadPoolFullAction: ActionParameterRules{
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "provider", ParameterType: BoolType},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 	},
 	JVM: {
 		JVMDelayAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "time", ParameterType: IntType, Required: true},
-				{Name: "offset", ParameterType: IntType},
+				{Name: "time", ParameterType: IntType, Required: true, Min: int64Ptr(0)},
+				{Name: "offset", ParameterType: IntType, Min: int64Ptr(0)},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "classname", Required: true},
 				{Name: "after", ParameterType: BoolType},
 				{Name: "methodname", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!has(params.time) || (params.time >= 0 && params.time <= 3600000)`,
+			},
 		},
 		JVMExceptionAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -443,26 +575,34 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "classname", Required: true},
 				{Name: "after", ParameterType: BoolType},
 				{Name: "methodname", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 		JVMCodeCacheFillingAction: ActionParameterRules{},
 		JVMCpuFullloadAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "cpu-count", ParameterType: IntType},
+				{Name: "cpu-count", ParameterType: IntType, Min: int64Ptr(1), Max: int64Ptr(256)},
 			},
 		},
 		JVMThrowDeclaredExceptionAction: ActionParameterRules{
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "classname", Required: true},
 				{Name: "after", ParameterType: BoolType},
 				{Name: "methodname", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 		JVMReturnAction: ActionParameterRules{
 			Flags: []ParameterRules{
@@ -470,41 +610,59 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "classname", Required: true},
 				{Name: "after", ParameterType: BoolType},
 				{Name: "methodname", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+			},
 		},
 		JVMScriptAction: ActionParameterRules{
 			Flags: []ParameterRules{
 				{Name: "script-file"},
-				{Name: "script-type"},
+				{Name: "script-type", Enum: []string{"beetl", "javascript", "groovy"}},
 				{Name: "script-content"},
 				{Name: "script-name"},
 			},
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
 				{Name: "classname", Required: true},
 				{Name: "after", ParameterType: BoolType},
 				{Name: "methodname", Required: true},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
+				`!(has(params["script-file"]) && has(params["script-content"]))`,
+				`!has(params["script-content"]) || has(params["script-type"])`,
+			},
 		},
 		JVMOOMAction: ActionParameterRules{
 			Flags: []ParameterRules{
-				{Name: "area", Required: true},
+				{Name: "area", Required: true, Enum: []string{"HEAP", "NOHEAP", "OFFHEAP"}},
 				{Name: "wild-mode", ParameterType: BoolType},
 				{Name: "interval", ParameterType: IntType},
 				{Name: "block", ParameterType: IntType},
 			},
+
+			CELExpressions: []string{
+				`!(has(params["wild-mode"]) && params["wild-mode"] && has(params.block) && params.block != 0)`,
+			},
 		},
 	},
 	DRUID: {
 		JVMConnectionPoolFullAction: ActionParameterRules{
 			Matcher: []ParameterRules{
 				{Name: "effect-count", ParameterType: IntType},
-				{Name: "effect-percent", ParameterType: IntType},
+				{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+			},
+
+			CELExpressions: []string{
+				`!(has(params["effect-count"]) && has(params["effect-percent"]))`,
 			},
 		},
 	},
@@ -513,6 +671,15 @@ var JvmSpec = map[JVMChaosTarget]map[JVMChaosAction]ActionParameterRules{
 type ActionParameterRules struct {
 	Flags   []ParameterRules
 	Matcher []ParameterRules
+
+	// CELExpressions are evaluated against a merged view of Flags and
+	// Matcher (see celParams) after every rule above has had its own
+	// presence/type check, whatever its outcome, for constraints that span more than one
+	// parameter: mutual exclusion, numeric ranges, "required together"
+	// relationships, and so on. Each expression must evaluate to a bool; a
+	// false result is reported as a field.Invalid carrying the expression
+	// source, so operators can see exactly which constraint failed.
+	CELExpressions []string
 }
 
 type ParameterType string
@@ -527,4 +694,18 @@ type ParameterRules struct {
 	Name          string
 	ParameterType ParameterType
 	Required      bool
+
+	// Min and Max bound an IntType value (inclusive). Either may be nil to
+	// leave that side unbounded.
+	Min *int64
+	Max *int64
+	// Enum restricts the value to one of a fixed set of strings.
+	Enum []string
+	// Pattern is a regexp a string value must match in full.
+	Pattern string
 }
+
+// These bounds are only enforced by this webhook today; generating matching
+// `x-kubernetes-validations` into the JVMChaos CRD, so `kubectl apply
+// --dry-run=server` reports the same errors, needs changes to the CRD
+// manifest generation that lives outside this package.
@@ -0,0 +1,105 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// TestValidateParameterRulesBounds exercises the Min/Max/Enum/Pattern
+// enforcement added to validateParameterRules, including the boundary
+// values themselves (0 and 100 for effect-percent, 1 and 256 for
+// cpu-count) where the rule must accept rather than reject.
+func TestValidateParameterRulesBounds(t *testing.T) {
+	rules := []ParameterRules{
+		{Name: "effect-percent", ParameterType: IntType, Min: int64Ptr(0), Max: int64Ptr(100)},
+		{Name: "cpu-count", ParameterType: IntType, Min: int64Ptr(1), Max: int64Ptr(256)},
+		{Name: "script-type", Enum: []string{"beetl", "javascript", "groovy"}},
+		{Name: "exception", Pattern: `[A-Za-z][A-Za-z0-9.]*Exception`},
+	}
+
+	cases := []struct {
+		name       string
+		parameters map[string]string
+		wantErr    bool
+	}{
+		{
+			name:       "Min violation: effect-percent below 0",
+			parameters: map[string]string{"effect-percent": "-1"},
+			wantErr:    true,
+		},
+		{
+			name:       "Max violation: effect-percent above 100",
+			parameters: map[string]string{"effect-percent": "250"},
+			wantErr:    true,
+		},
+		{
+			name:       "lower boundary: effect-percent at 0 is accepted",
+			parameters: map[string]string{"effect-percent": "0"},
+		},
+		{
+			name:       "upper boundary: effect-percent at 100 is accepted",
+			parameters: map[string]string{"effect-percent": "100"},
+		},
+		{
+			name:       "Min violation: cpu-count below 1",
+			parameters: map[string]string{"cpu-count": "0"},
+			wantErr:    true,
+		},
+		{
+			name:       "Max violation: cpu-count above 256",
+			parameters: map[string]string{"cpu-count": "99999"},
+			wantErr:    true,
+		},
+		{
+			name:       "lower boundary: cpu-count at 1 is accepted",
+			parameters: map[string]string{"cpu-count": "1"},
+		},
+		{
+			name:       "upper boundary: cpu-count at 256 is accepted",
+			parameters: map[string]string{"cpu-count": "256"},
+		},
+		{
+			name:       "Enum violation: script-type not in the allowed list",
+			parameters: map[string]string{"script-type": "lolcode"},
+			wantErr:    true,
+		},
+		{
+			name:       "Enum match: script-type in the allowed list",
+			parameters: map[string]string{"script-type": "javascript"},
+		},
+		{
+			name:       "Pattern mismatch: exception does not look like a class name",
+			parameters: map[string]string{"exception": "not an exception"},
+			wantErr:    true,
+		},
+		{
+			name:       "Pattern match: exception looks like a class name",
+			parameters: map[string]string{"exception": "java.lang.RuntimeException"},
+		},
+	}
+
+	in := &JVMChaos{}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errs := in.validateParameterRules(c.parameters, rules, field.NewPath("spec").Child("flags"),
+				field.NewPath("spec").Child("target"), field.NewPath("spec").Child("action"))
+			if gotErr := len(errs) > 0; gotErr != c.wantErr {
+				t.Errorf("validateParameterRules() errors = %v, wantErr %v", errs, c.wantErr)
+			}
+		})
+	}
+}